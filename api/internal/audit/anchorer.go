@@ -0,0 +1,193 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/contractsafe/api/internal/blockchain"
+)
+
+// anchorBatchSize is the maximum number of unbatched leaves an Anchorer
+// sweeps into a single batch.
+const anchorBatchSize = 256
+
+// anchorInterval is how often the Anchorer checks for leaves to batch. Each
+// check anchors at most anchorBatchSize leaves, so when more are waiting the
+// rest wait for later checks; reaching anchorBatchSize does not start a
+// check early.
+const anchorInterval = 60 * time.Second
+
+// Anchorer batches unbatched merkle_leaves rows into Merkle trees, persists
+// their internal nodes and root, and optionally submits the root on-chain
+// when the blockchain client has a signer configured.
+type Anchorer struct {
+	db               *sql.DB
+	blockchainClient *blockchain.Client
+}
+
+// NewAnchorer creates an Anchorer.
+func NewAnchorer(db *sql.DB, blockchainClient *blockchain.Client) *Anchorer {
+	return &Anchorer{db: db, blockchainClient: blockchainClient}
+}
+
+// Run batches and anchors leaves on anchorInterval until ctx is cancelled.
+func (a *Anchorer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(anchorInterval)
+	defer ticker.Stop()
+
+	if err := a.anchorOnce(ctx); err != nil {
+		log.Printf("anchorer: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := a.anchorOnce(ctx); err != nil {
+				log.Printf("anchorer: %v", err)
+			}
+		}
+	}
+}
+
+// anchorOnce batches every currently unbatched leaf (up to anchorBatchSize)
+// into a single tree, persists it, and anchors it on-chain if a signer is
+// configured. It is a no-op if there are no unbatched leaves.
+func (a *Anchorer) anchorOnce(ctx context.Context) error {
+	hashes, eventHashes, err := a.loadUnbatchedLeaves(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load unbatched leaves: %w", err)
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	tree := NewTree(hashes)
+	root := tree.Root()
+
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var batchID int64
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO merkle_roots (root, leaf_count) VALUES ($1, $2) RETURNING batch_id`,
+		hashHex(root), len(hashes),
+	).Scan(&batchID)
+	if err != nil {
+		return fmt.Errorf("failed to insert merkle root: %w", err)
+	}
+
+	for i, eventHash := range eventHashes {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE merkle_leaves SET batch_id = $1, leaf_index = $2 WHERE event_hash = $3`,
+			batchID, i, eventHash,
+		); err != nil {
+			return fmt.Errorf("failed to assign leaf to batch: %w", err)
+		}
+	}
+
+	if err := a.storeNodes(ctx, tx, batchID, tree); err != nil {
+		return fmt.Errorf("failed to store merkle nodes: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	if a.blockchainClient != nil && a.blockchainClient.HasSigner() {
+		if err := a.anchorOnChain(ctx, batchID, root); err != nil {
+			log.Printf("anchorer: failed to anchor batch %d on-chain: %v", batchID, err)
+		}
+	}
+
+	return nil
+}
+
+// loadUnbatchedLeaves returns, in insertion order, the leaf hashes and
+// event hashes of every merkle_leaves row not yet assigned to a batch.
+func (a *Anchorer) loadUnbatchedLeaves(ctx context.Context) ([][32]byte, []string, error) {
+	rows, err := a.db.QueryContext(ctx,
+		`SELECT event_hash, leaf_hash FROM merkle_leaves WHERE batch_id IS NULL ORDER BY id ASC LIMIT $1`,
+		anchorBatchSize,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var hashes [][32]byte
+	var eventHashes []string
+	for rows.Next() {
+		var eventHash, leafHashHex string
+		if err := rows.Scan(&eventHash, &leafHashHex); err != nil {
+			return nil, nil, err
+		}
+
+		leafHash, err := parseHash(leafHashHex)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		hashes = append(hashes, leafHash)
+		eventHashes = append(eventHashes, eventHash)
+	}
+
+	return hashes, eventHashes, rows.Err()
+}
+
+// storeNodes persists every level of tree, including the leaves, so a
+// proof can later be assembled by direct lookup instead of rebuilding it.
+func (a *Anchorer) storeNodes(ctx context.Context, tx *sql.Tx, batchID int64, tree *Tree) error {
+	for level, nodes := range tree.levels {
+		for index, node := range nodes {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO merkle_nodes (batch_id, level, index, hash) VALUES ($1, $2, $3, $4)`,
+				batchID, level, index, hashHex(node),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// anchorOnChain submits root via the blockchain client's AnchorRoot and
+// records the resulting transaction hash against the batch.
+func (a *Anchorer) anchorOnChain(ctx context.Context, batchID int64, root [32]byte) error {
+	txHash, err := a.blockchainClient.AnchorRoot(ctx, root)
+	if err != nil {
+		return fmt.Errorf("failed to submit anchorRoot: %w", err)
+	}
+
+	_, err = a.db.ExecContext(ctx,
+		`UPDATE merkle_roots SET tx_hash = $1 WHERE batch_id = $2`,
+		txHash.Hex(), batchID,
+	)
+	return err
+}
+
+func hashHex(h [32]byte) string {
+	return "0x" + hex.EncodeToString(h[:])
+}
+
+func parseHash(s string) ([32]byte, error) {
+	var out [32]byte
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return out, err
+	}
+	if len(b) != 32 {
+		return out, fmt.Errorf("expected 32-byte hash, got %d bytes", len(b))
+	}
+	copy(out[:], b)
+	return out, nil
+}
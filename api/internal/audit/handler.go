@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Handler serves read access to the Merkle audit log over HTTP.
+type Handler struct {
+	db *sql.DB
+}
+
+// NewHandler creates a Handler.
+func NewHandler(db *sql.DB) *Handler {
+	return &Handler{db: db}
+}
+
+// proofResponse is the JSON body returned by GET /audit/proof/{event_hash}.
+type proofResponse struct {
+	Leaf      string   `json:"leaf"`
+	LeafIndex int64    `json:"leaf_index"`
+	LeafCount int      `json:"leaf_count"`
+	BatchID   int64    `json:"batch_id"`
+	Root      string   `json:"root"`
+	Siblings  []string `json:"siblings"`
+}
+
+// HandleProof returns the Merkle inclusion proof for a processed event,
+// identified by its event hash. It 404s if the event hasn't been seen, or
+// has been seen but not yet swept into a batch by the Anchorer.
+func (h *Handler) HandleProof(w http.ResponseWriter, r *http.Request) {
+	eventHash := mux.Vars(r)["event_hash"]
+
+	var leafHash string
+	var batchID, leafIndex sql.NullInt64
+	err := h.db.QueryRowContext(r.Context(),
+		`SELECT leaf_hash, batch_id, leaf_index FROM merkle_leaves WHERE event_hash = $1`,
+		eventHash,
+	).Scan(&leafHash, &batchID, &leafIndex)
+	if err == sql.ErrNoRows {
+		http.Error(w, "event not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "failed to load leaf", http.StatusInternalServerError)
+		return
+	}
+	if !batchID.Valid {
+		http.Error(w, "event not yet anchored", http.StatusNotFound)
+		return
+	}
+
+	var root string
+	var leafCount int
+	err = h.db.QueryRowContext(r.Context(),
+		`SELECT root, leaf_count FROM merkle_roots WHERE batch_id = $1`,
+		batchID.Int64,
+	).Scan(&root, &leafCount)
+	if err != nil {
+		http.Error(w, "failed to load batch root", http.StatusInternalServerError)
+		return
+	}
+
+	siblings, err := h.loadSiblings(r.Context(), batchID.Int64, int(leafIndex.Int64), leafCount)
+	if err != nil {
+		http.Error(w, "failed to load proof siblings", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(proofResponse{
+		Leaf:      leafHash,
+		LeafIndex: leafIndex.Int64,
+		LeafCount: leafCount,
+		BatchID:   batchID.Int64,
+		Root:      root,
+		Siblings:  siblings,
+	})
+}
+
+// loadSiblings walks the same level-by-level halving Tree.Proof walks,
+// fetching each level's sibling hash directly from merkle_nodes instead of
+// rebuilding the tree in memory.
+func (h *Handler) loadSiblings(ctx context.Context, batchID int64, leafIndex, leafCount int) ([]string, error) {
+	var siblings []string
+
+	idx, count, level := leafIndex, leafCount, 0
+	for count > 1 {
+		var siblingIdx int
+		if idx%2 == 0 {
+			siblingIdx = idx + 1
+		} else {
+			siblingIdx = idx - 1
+		}
+
+		if siblingIdx < count {
+			var hash string
+			err := h.db.QueryRowContext(ctx,
+				`SELECT hash FROM merkle_nodes WHERE batch_id = $1 AND level = $2 AND index = $3`,
+				batchID, level, siblingIdx,
+			).Scan(&hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load sibling at level %d index %d: %w", level, siblingIdx, err)
+			}
+			siblings = append(siblings, hash)
+		}
+
+		idx /= 2
+		count = (count + 1) / 2
+		level++
+	}
+
+	return siblings, nil
+}
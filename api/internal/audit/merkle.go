@@ -0,0 +1,168 @@
+// Package audit builds and verifies RFC 6962-style Merkle proofs over the
+// events the orchestrator has processed, so an operator (or eventually an
+// on-chain verifier) can prove which events were actually handled.
+package audit
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// Domain-separation prefixes prevent a second-preimage attack where an
+// internal node hash is replayed as if it were a leaf hash (RFC 6962 §2.1).
+var (
+	leafPrefix = []byte{0x00}
+	nodePrefix = []byte{0x01}
+)
+
+// LeafHash returns the domain-separated hash of leaf data.
+func LeafHash(data []byte) [32]byte {
+	return sha256.Sum256(append(leafPrefix, data...))
+}
+
+func combine(left, right [32]byte) [32]byte {
+	buf := make([]byte, 0, 1+32+32)
+	buf = append(buf, nodePrefix...)
+	buf = append(buf, left[:]...)
+	buf = append(buf, right[:]...)
+	return sha256.Sum256(buf)
+}
+
+// ProofStep is one level of a Merkle inclusion proof. Combined is false for
+// a level where the leaf's ancestor had no sibling (an odd node promoted
+// unchanged to the next level), in which case Sibling is unused.
+type ProofStep struct {
+	Sibling  [32]byte
+	OnRight  bool
+	Combined bool
+}
+
+// Tree is an in-memory Merkle tree built over precomputed leaf hashes.
+type Tree struct {
+	levels [][][32]byte // levels[0] = leaves, levels[len-1] = [root]
+}
+
+// NewTree builds a Merkle tree over leaves. A level with an odd number of
+// nodes promotes its last node unchanged to the next level rather than
+// duplicating it.
+func NewTree(leaves [][32]byte) *Tree {
+	if len(leaves) == 0 {
+		return &Tree{levels: [][][32]byte{{}}}
+	}
+
+	levels := [][][32]byte{leaves}
+	current := leaves
+	for len(current) > 1 {
+		next := make([][32]byte, 0, (len(current)+1)/2)
+		for i := 0; i < len(current); i += 2 {
+			if i+1 < len(current) {
+				next = append(next, combine(current[i], current[i+1]))
+			} else {
+				next = append(next, current[i])
+			}
+		}
+		levels = append(levels, next)
+		current = next
+	}
+
+	return &Tree{levels: levels}
+}
+
+// Root returns the tree's root hash. The empty tree's root is defined as
+// the domain-separated leaf hash of no data, so it can never collide with
+// a real leaf or internal node hash.
+func (t *Tree) Root() [32]byte {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return LeafHash(nil)
+	}
+	return top[0]
+}
+
+// LeafCount returns the number of leaves the tree was built from.
+func (t *Tree) LeafCount() int {
+	return len(t.levels[0])
+}
+
+// Proof returns the inclusion proof for the leaf at index, one ProofStep
+// per level of the tree from the leaf up to (but not including) the root.
+func (t *Tree) Proof(index int) ([]ProofStep, error) {
+	if index < 0 || index >= len(t.levels[0]) {
+		return nil, fmt.Errorf("leaf index %d out of range for %d leaves", index, len(t.levels[0]))
+	}
+
+	var steps []ProofStep
+	idx := index
+	for level := 0; level < len(t.levels)-1; level++ {
+		nodes := t.levels[level]
+
+		var step ProofStep
+		if idx%2 == 0 {
+			if idx+1 < len(nodes) {
+				step = ProofStep{Sibling: nodes[idx+1], OnRight: true, Combined: true}
+			}
+		} else {
+			step = ProofStep{Sibling: nodes[idx-1], OnRight: false, Combined: true}
+		}
+
+		steps = append(steps, step)
+		idx /= 2
+	}
+
+	return steps, nil
+}
+
+// VerifyProof recomputes the root from leaf and steps, and reports whether
+// it matches root.
+func VerifyProof(leaf [32]byte, steps []ProofStep, root [32]byte) bool {
+	current := leaf
+	for _, step := range steps {
+		if !step.Combined {
+			continue
+		}
+		if step.OnRight {
+			current = combine(current, step.Sibling)
+		} else {
+			current = combine(step.Sibling, current)
+		}
+	}
+	return current == root
+}
+
+// VerifyInclusion verifies an inclusion proof from only a leaf index, the
+// total leaf count, and the sibling hashes actually combined along the
+// path to the root. It mirrors the same level-by-level halving NewTree's
+// Proof walks, so a caller that only has an /audit/proof response (and not
+// the original Tree) can verify it without reconstructing the tree.
+func VerifyInclusion(leaf [32]byte, leafIndex, leafCount int, siblings [][32]byte, root [32]byte) bool {
+	if leafIndex < 0 || leafIndex >= leafCount {
+		return false
+	}
+
+	current := leaf
+	idx, count := leafIndex, leafCount
+	si := 0
+	for count > 1 {
+		hasSibling := idx%2 == 1 || idx+1 < count
+		if hasSibling {
+			if si >= len(siblings) {
+				return false
+			}
+			sibling := siblings[si]
+			si++
+			if idx%2 == 0 {
+				current = combine(current, sibling)
+			} else {
+				current = combine(sibling, current)
+			}
+		}
+		idx /= 2
+		count = (count + 1) / 2
+	}
+
+	if si != len(siblings) {
+		return false
+	}
+
+	return current == root
+}
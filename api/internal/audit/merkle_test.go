@@ -0,0 +1,137 @@
+package audit
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestEmptyTree(t *testing.T) {
+	tree := NewTree(nil)
+
+	if got, want := tree.Root(), LeafHash(nil); got != want {
+		t.Fatalf("empty tree root = %x, want %x", got, want)
+	}
+
+	if _, err := tree.Proof(0); err == nil {
+		t.Fatal("expected error proving a leaf in an empty tree")
+	}
+}
+
+func TestSingleLeafTree(t *testing.T) {
+	leaf := LeafHash([]byte("only-leaf"))
+	tree := NewTree([][32]byte{leaf})
+
+	if got := tree.Root(); got != leaf {
+		t.Fatalf("single-leaf tree root = %x, want leaf hash %x", got, leaf)
+	}
+
+	proof, err := tree.Proof(0)
+	if err != nil {
+		t.Fatalf("Proof returned error: %v", err)
+	}
+	if len(proof) != 0 {
+		t.Fatalf("expected an empty proof for a single-leaf tree, got %d steps", len(proof))
+	}
+	if !VerifyProof(leaf, proof, tree.Root()) {
+		t.Fatal("expected single-leaf proof to verify")
+	}
+}
+
+func TestProofVerificationForArbitraryIndices(t *testing.T) {
+	for _, leafCount := range []int{2, 3, 4, 5, 7, 8, 13, 16} {
+		leafCount := leafCount
+		t.Run("leaves_"+strconv.Itoa(leafCount), func(t *testing.T) {
+			leaves := make([][32]byte, leafCount)
+			for i := range leaves {
+				leaves[i] = LeafHash([]byte{byte(i)})
+			}
+
+			tree := NewTree(leaves)
+			root := tree.Root()
+
+			for i := range leaves {
+				proof, err := tree.Proof(i)
+				if err != nil {
+					t.Fatalf("Proof(%d) returned error: %v", i, err)
+				}
+				if !VerifyProof(leaves[i], proof, root) {
+					t.Fatalf("leaf %d/%d failed to verify against root", i, leafCount)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyProofRejectsTamperedLeaf(t *testing.T) {
+	leaves := [][32]byte{
+		LeafHash([]byte("a")),
+		LeafHash([]byte("b")),
+		LeafHash([]byte("c")),
+	}
+	tree := NewTree(leaves)
+
+	proof, err := tree.Proof(1)
+	if err != nil {
+		t.Fatalf("Proof returned error: %v", err)
+	}
+
+	tampered := LeafHash([]byte("not-b"))
+	if VerifyProof(tampered, proof, tree.Root()) {
+		t.Fatal("expected VerifyProof to reject a tampered leaf")
+	}
+}
+
+func TestProofOutOfRange(t *testing.T) {
+	tree := NewTree([][32]byte{LeafHash([]byte("a"))})
+
+	if _, err := tree.Proof(-1); err == nil {
+		t.Fatal("expected error for negative index")
+	}
+	if _, err := tree.Proof(1); err == nil {
+		t.Fatal("expected error for out-of-range index")
+	}
+}
+
+func TestVerifyInclusionMatchesProof(t *testing.T) {
+	for _, leafCount := range []int{1, 2, 3, 5, 8, 13} {
+		leafCount := leafCount
+		t.Run("leaves_"+strconv.Itoa(leafCount), func(t *testing.T) {
+			leaves := make([][32]byte, leafCount)
+			for i := range leaves {
+				leaves[i] = LeafHash([]byte{byte(i), byte(i + 1)})
+			}
+
+			tree := NewTree(leaves)
+			root := tree.Root()
+
+			for i := range leaves {
+				proof, err := tree.Proof(i)
+				if err != nil {
+					t.Fatalf("Proof(%d) returned error: %v", i, err)
+				}
+
+				var siblings [][32]byte
+				for _, step := range proof {
+					if step.Combined {
+						siblings = append(siblings, step.Sibling)
+					}
+				}
+
+				if !VerifyInclusion(leaves[i], i, leafCount, siblings, root) {
+					t.Fatalf("VerifyInclusion failed for leaf %d/%d", i, leafCount)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyInclusionRejectsOutOfRangeIndex(t *testing.T) {
+	leaf := LeafHash([]byte("a"))
+	if VerifyInclusion(leaf, -1, 1, nil, leaf) {
+		t.Fatal("expected VerifyInclusion to reject negative index")
+	}
+	if VerifyInclusion(leaf, 1, 1, nil, leaf) {
+		t.Fatal("expected VerifyInclusion to reject out-of-range index")
+	}
+}
+
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/contractsafe/api/internal/blockchain"
+	"go.uber.org/fx"
+)
+
+// Module provides the audit Handler to the fx application graph and runs
+// the Anchorer for its lifetime.
+var Module = fx.Module("audit",
+	fx.Provide(NewHandler),
+	fx.Invoke(runAnchorer),
+)
+
+func runAnchorer(lc fx.Lifecycle, db *sql.DB, blockchainClient *blockchain.Client) {
+	anchorer := NewAnchorer(db, blockchainClient)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				if err := anchorer.Run(ctx); err != nil {
+					log.Printf("anchorer: stopped: %v", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
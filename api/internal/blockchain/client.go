@@ -4,61 +4,276 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
+	"github.com/contractsafe/api/internal/blockchain/escrow"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
+// anchorRootABI is the minimal ABI fragment for the optional on-chain audit
+// anchor method. It is kept separate from the generated Escrow bindings
+// since the audit log works without a deployed anchor contract.
+const anchorRootABI = `[{"inputs":[{"internalType":"bytes32","name":"root","type":"bytes32"}],"name":"anchorRoot","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// maxGasTipCap caps the EIP-1559 priority fee we're willing to pay, so a
+// momentary spike in network congestion can't run away with gas costs.
+var maxGasTipCap = big.NewInt(30_000_000_000) // 30 gwei
+
+// receiptPollInterval is how often we poll for a transaction receipt while
+// waiting for inclusion.
+const receiptPollInterval = 2 * time.Second
+
+// ethBackend is the subset of *ethclient.Client this package depends on. It
+// exists so tests can substitute *backends.SimulatedBackend, which
+// implements the same methods but isn't an *ethclient.Client.
+type ethBackend interface {
+	bind.ContractBackend
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
 // Client handles blockchain interactions
 type Client struct {
-	client          *ethclient.Client
+	client          ethBackend
 	contractAddress common.Address
+	contract        *escrow.Escrow
+	signer          SignerConfig
+	hasSigner       bool
 }
 
-// NewClient creates a new blockchain client
-func NewClient(rpcURL string, contractAddress string) (*Client, error) {
+// NewClient creates a new blockchain client. signer may be the zero value
+// for read-only usage (GetTaskState); TransitionToValidating requires a
+// signer to be configured.
+func NewClient(rpcURL string, contractAddress string, signer SignerConfig) (*Client, error) {
 	client, err := ethclient.Dial(rpcURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to blockchain: %w", err)
 	}
 
+	addr := common.HexToAddress(contractAddress)
+	contract, err := escrow.NewEscrow(addr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind escrow contract: %w", err)
+	}
+
+	hasSigner := signer.KeystorePath != "" || signer.PrivateKeyHex != ""
+
 	return &Client{
 		client:          client,
-		contractAddress: common.HexToAddress(contractAddress),
+		contractAddress: addr,
+		contract:        contract,
+		signer:          signer,
+		hasSigner:       hasSigner,
 	}, nil
 }
 
-// TransitionToValidating transitions a task to Validating state
-// This would be called by the orchestrator when routing an ApprovalRequested event
+// TransitionToValidating transitions a task to Validating state.
+// It builds EIP-1559 transact options, submits the transaction through the
+// generated escrow bindings, and blocks until the transaction is mined.
 func (c *Client) TransitionToValidating(ctx context.Context, taskID *big.Int) error {
-	// Note: This is a placeholder. In a real implementation, you would:
-	// 1. Load the contract ABI
-	// 2. Create a transaction to call the contract method
-	// 3. Sign and send the transaction
-	// 4. Wait for confirmation
-	
-	// For now, we'll just log the action
-	fmt.Printf("Transitioning task %s to Validating state\n", taskID.String())
-	
-	// TODO: Implement actual contract interaction
-	// This requires:
-	// - Private key for signing transactions
-	// - Contract ABI bindings (generated from Solidity)
-	// - Gas estimation and transaction management
-	
+	if !c.hasSigner {
+		return fmt.Errorf("blockchain client has no signer configured")
+	}
+
+	opts, err := c.transactOpts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build transact options: %w", err)
+	}
+
+	tx, err := c.contract.TransitionToValidating(opts, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to send transitionToValidating: %w", err)
+	}
+
+	receipt, err := c.waitMined(ctx, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("failed waiting for transitionToValidating to be mined: %w", err)
+	}
+
+	if receipt.Status == 0 {
+		return fmt.Errorf("transitionToValidating reverted: tx %s", tx.Hash().Hex())
+	}
+
 	return nil
 }
 
-// GetTaskState retrieves the current state of a task
-func (c *Client) GetTaskState(ctx context.Context, taskID *big.Int) (uint8, error) {
-	// Placeholder for getting task state from contract
-	fmt.Printf("Getting state for task %s\n", taskID.String())
-	
-	// TODO: Implement actual contract call
-	return 0, nil
+// GetTaskState retrieves the current state of a task. If blockNumber is
+// nil, the call is made against the latest block.
+func (c *Client) GetTaskState(ctx context.Context, taskID *big.Int, blockNumber *big.Int) (uint8, error) {
+	opts := &bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: blockNumber,
+	}
+
+	state, err := c.contract.GetTaskState(opts, taskID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to call getTaskState: %w", err)
+	}
+
+	return state, nil
+}
+
+// transactOpts builds a *bind.TransactOpts with nonce, gas tip, and gas fee
+// cap populated for the signer configured on this client.
+func (c *Client) transactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	opts, err := buildTransactOpts(c.signer)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+
+	nonce, err := c.client.NonceAt(ctx, opts.From, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load nonce: %w", err)
+	}
+	opts.Nonce = big.NewInt(int64(nonce))
+
+	tipCap, err := c.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		// Some RPC providers don't support eth_maxPriorityFeePerGas; fall
+		// back to the legacy gas price suggestion as the tip.
+		tipCap, err = c.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to suggest gas tip cap: %w", err)
+		}
+	}
+	if tipCap.Cmp(maxGasTipCap) > 0 {
+		tipCap = new(big.Int).Set(maxGasTipCap)
+	}
+	opts.GasTipCap = tipCap
+
+	head, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head header: %w", err)
+	}
+	if head.BaseFee != nil {
+		feeCap := new(big.Int).Add(tipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+		opts.GasFeeCap = feeCap
+	}
+
+	return opts, nil
+}
+
+// waitMined polls for the receipt of txHash every receiptPollInterval until
+// it is found or ctx is cancelled. It is a local re-implementation of
+// bind.WaitMined that lets us control the poll interval explicitly.
+func (c *Client) waitMined(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := c.client.TransactionReceipt(ctx, txHash)
+		if err == nil {
+			return receipt, nil
+		}
+		if err != ethereum.NotFound {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// HeadBlock returns the current chain head block number.
+func (c *Client) HeadBlock(ctx context.Context) (uint64, error) {
+	header, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+// TransactionReceipt returns the receipt for txHash, or nil if it has not
+// been mined (yet, or any longer, in the case of a reorg).
+func (c *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := c.client.TransactionReceipt(ctx, txHash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	return receipt, err
+}
+
+// BlockHashAt returns the canonical block hash at blockNumber, used to
+// detect whether a previously seen block has since been reorged out.
+func (c *Client) BlockHashAt(ctx context.Context, blockNumber int64) (common.Hash, error) {
+	header, err := c.client.HeaderByNumber(ctx, big.NewInt(blockNumber))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Hash(), nil
+}
+
+// EthClient exposes the underlying RPC client for callers that need direct
+// chain access, such as the indexer's log subscription. It returns nil if
+// the client isn't backed by a real *ethclient.Client (e.g. in tests).
+func (c *Client) EthClient() *ethclient.Client {
+	client, _ := c.client.(*ethclient.Client)
+	return client
+}
+
+// HasSigner reports whether a signer is configured, i.e. whether
+// TransitionToValidating or AnchorRoot can be called.
+func (c *Client) HasSigner() bool {
+	return c.hasSigner
 }
 
-// Close closes the blockchain client connection
+// AnchorRoot submits a Merkle root to the configured contract's
+// anchorRoot(bytes32) method, for the audit log's optional on-chain
+// anchoring. It returns the submitting transaction's hash once mined.
+func (c *Client) AnchorRoot(ctx context.Context, root [32]byte) (common.Hash, error) {
+	if !c.hasSigner {
+		return common.Hash{}, fmt.Errorf("blockchain client has no signer configured")
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(anchorRootABI))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to parse anchorRoot ABI: %w", err)
+	}
+
+	bound := bind.NewBoundContract(c.contractAddress, parsed, c.client, c.client, c.client)
+
+	opts, err := c.transactOpts(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to build transact options: %w", err)
+	}
+
+	tx, err := bound.Transact(opts, "anchorRoot", root)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send anchorRoot: %w", err)
+	}
+
+	receipt, err := c.waitMined(ctx, tx.Hash())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed waiting for anchorRoot to be mined: %w", err)
+	}
+	if receipt.Status == 0 {
+		return common.Hash{}, fmt.Errorf("anchorRoot reverted: tx %s", tx.Hash().Hex())
+	}
+
+	return tx.Hash(), nil
+}
+
+// ContractAddress returns the Escrow contract address this client is bound
+// to.
+func (c *Client) ContractAddress() common.Address {
+	return c.contractAddress
+}
+
+// Close closes the blockchain client connection, if the underlying
+// backend supports closing (e.g. a real *ethclient.Client; test backends
+// typically manage their own lifecycle).
 func (c *Client) Close() {
-	c.client.Close()
+	if closer, ok := c.client.(interface{ Close() }); ok {
+		closer.Close()
+	}
 }
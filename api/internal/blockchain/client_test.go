@@ -0,0 +1,143 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/contractsafe/api/internal/blockchain/escrow"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var simulatedChainID = big.NewInt(1337)
+
+// newSimulatedClient deploys the Escrow stub contract to a SimulatedBackend
+// and wraps it in a Client signing with the deployer account.
+func newSimulatedClient(t *testing.T) (*Client, *backends.SimulatedBackend) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate signer key: %v", err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, simulatedChainID)
+	if err != nil {
+		t.Fatalf("failed to build transactor: %v", err)
+	}
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		auth.From: {Balance: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))},
+	}, 8_000_000)
+
+	address, _, _, err := escrow.DeployEscrow(auth, backend)
+	if err != nil {
+		t.Fatalf("failed to deploy escrow: %v", err)
+	}
+	backend.Commit()
+
+	contract, err := escrow.NewEscrow(address, backend)
+	if err != nil {
+		t.Fatalf("failed to bind deployed escrow: %v", err)
+	}
+
+	client := &Client{
+		client:          backend,
+		contractAddress: address,
+		contract:        contract,
+		signer: SignerConfig{
+			PrivateKeyHex: hex.EncodeToString(crypto.FromECDSA(key)),
+			ChainID:       simulatedChainID,
+		},
+		hasSigner: true,
+	}
+
+	return client, backend
+}
+
+func TestGetTaskStateDefaultsToZero(t *testing.T) {
+	client, backend := newSimulatedClient(t)
+	defer backend.Close()
+
+	state, err := client.GetTaskState(context.Background(), big.NewInt(1), nil)
+	if err != nil {
+		t.Fatalf("GetTaskState returned error: %v", err)
+	}
+	if state != 0 {
+		t.Fatalf("expected default task state 0, got %d", state)
+	}
+}
+
+func TestGetTaskStateWithoutSigner(t *testing.T) {
+	client, backend := newSimulatedClient(t)
+	defer backend.Close()
+
+	client.hasSigner = false
+	client.signer = SignerConfig{}
+
+	if _, err := client.GetTaskState(context.Background(), big.NewInt(1), nil); err != nil {
+		t.Fatalf("GetTaskState should not require a signer, got error: %v", err)
+	}
+}
+
+func TestTransitionToValidatingWithoutSignerFails(t *testing.T) {
+	client, backend := newSimulatedClient(t)
+	defer backend.Close()
+
+	client.hasSigner = false
+	client.signer = SignerConfig{}
+
+	if err := client.TransitionToValidating(context.Background(), big.NewInt(1)); err == nil {
+		t.Fatal("expected TransitionToValidating to fail without a configured signer")
+	}
+}
+
+// TestTransitionToValidatingMinesAndUpdatesState exercises the full signed
+// path: it sends the transaction through the generated bindings and waits
+// for waitMined to observe a receipt, committing blocks on the simulated
+// backend in the background since nothing mines them automatically. It
+// then checks the resulting state via a follow-up GetTaskState call.
+func TestTransitionToValidatingMinesAndUpdatesState(t *testing.T) {
+	client, backend := newSimulatedClient(t)
+	defer backend.Close()
+
+	taskID := big.NewInt(1)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.TransitionToValidating(context.Background(), taskID)
+	}()
+
+	stopCommitting := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCommitting:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+
+	err := <-done
+	close(stopCommitting)
+
+	if err != nil {
+		t.Fatalf("TransitionToValidating returned error: %v", err)
+	}
+
+	state, err := client.GetTaskState(context.Background(), taskID, nil)
+	if err != nil {
+		t.Fatalf("GetTaskState returned error: %v", err)
+	}
+	if state != 1 {
+		t.Fatalf("expected task state 1 (Validating) after transition, got %d", state)
+	}
+}
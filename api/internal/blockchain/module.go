@@ -0,0 +1,45 @@
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"os"
+
+	"go.uber.org/fx"
+)
+
+// Module provides the blockchain Client to the fx application graph and
+// closes its RPC connection on shutdown.
+var Module = fx.Module("blockchain",
+	fx.Provide(newFromEnv),
+	fx.Invoke(registerHooks),
+)
+
+// newFromEnv builds a Client from POLYGON_RPC_URL, ESCROW_CONTRACT_ADDRESS,
+// and the SIGNER_* environment variables.
+func newFromEnv() (*Client, error) {
+	var chainID *big.Int
+	if v := os.Getenv("POLYGON_CHAIN_ID"); v != "" {
+		chainID, _ = new(big.Int).SetString(v, 10)
+	}
+
+	return NewClient(
+		os.Getenv("POLYGON_RPC_URL"),
+		os.Getenv("ESCROW_CONTRACT_ADDRESS"),
+		SignerConfig{
+			KeystorePath:  os.Getenv("SIGNER_KEYSTORE_PATH"),
+			Passphrase:    os.Getenv("SIGNER_KEYSTORE_PASSPHRASE"),
+			PrivateKeyHex: os.Getenv("SIGNER_PRIVATE_KEY"),
+			ChainID:       chainID,
+		},
+	)
+}
+
+func registerHooks(lc fx.Lifecycle, client *Client) {
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			client.Close()
+			return nil
+		},
+	})
+}
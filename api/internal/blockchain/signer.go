@@ -0,0 +1,64 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignerConfig configures how the Client signs outgoing transactions.
+//
+// Exactly one of (KeystorePath, PrivateKeyHex) should be set. If neither is
+// set, the Client can still be used for read-only calls such as
+// GetTaskState, but TransitionToValidating will fail.
+type SignerConfig struct {
+	KeystorePath string
+	Passphrase   string
+
+	PrivateKeyHex string
+
+	ChainID *big.Int
+}
+
+// buildTransactOpts derives a *bind.TransactOpts from the configured signer.
+func buildTransactOpts(cfg SignerConfig) (*bind.TransactOpts, error) {
+	if cfg.ChainID == nil {
+		return nil, fmt.Errorf("signer config missing chain ID")
+	}
+
+	switch {
+	case cfg.PrivateKeyHex != "":
+		key, err := crypto.HexToECDSA(cfg.PrivateKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+		return bind.NewKeyedTransactorWithChainID(key, cfg.ChainID)
+
+	case cfg.KeystorePath != "":
+		return transactOptsFromKeystore(cfg)
+
+	default:
+		return nil, fmt.Errorf("signer config must set KeystorePath or PrivateKeyHex")
+	}
+}
+
+// transactOptsFromKeystore decrypts the key file at KeystorePath with
+// Passphrase and builds transact options that sign with the decrypted
+// private key.
+func transactOptsFromKeystore(cfg SignerConfig) (*bind.TransactOpts, error) {
+	keyJSON, err := os.ReadFile(cfg.KeystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, cfg.Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+
+	return bind.NewKeyedTransactorWithChainID(key.PrivateKey, cfg.ChainID)
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"os"
+
+	"go.uber.org/fx"
+)
+
+// Module provides the Postgres connection to the fx application graph,
+// running migrations before the connection is handed to other modules, and
+// closes it on shutdown.
+var Module = fx.Module("database",
+	fx.Provide(newFromEnv),
+	fx.Invoke(registerHooks),
+)
+
+// newFromEnv connects to Postgres using DATABASE_URL and applies migrations.
+func newFromEnv() (*sql.DB, error) {
+	db, err := NewPostgresDB(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := RunMigrations(db); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func registerHooks(lc fx.Lifecycle, db *sql.DB) {
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return db.Close()
+		},
+	})
+}
@@ -36,15 +36,67 @@ func RunMigrations(db *sql.DB) error {
 			event_type VARCHAR(50) NOT NULL,
 			task_id BIGINT NOT NULL,
 			block_number BIGINT NOT NULL,
+			block_hash VARCHAR(66) NOT NULL DEFAULT '',
 			transaction_hash VARCHAR(66) NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pending',
+			finalized_at TIMESTAMP,
 			processed_at TIMESTAMP NOT NULL DEFAULT NOW(),
 			payload JSONB NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT NOW()
 		)`,
+		// Backfill columns for deployments where the events table already
+		// existed before finality gating was introduced.
+		`ALTER TABLE events ADD COLUMN IF NOT EXISTS block_hash VARCHAR(66) NOT NULL DEFAULT ''`,
+		`ALTER TABLE events ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'pending'`,
+		`ALTER TABLE events ADD COLUMN IF NOT EXISTS finalized_at TIMESTAMP`,
+		// first_seen_block was always inserted equal to block_number and
+		// never updated afterward, so it never carried information
+		// block_number didn't already have. Dropped rather than kept as
+		// dead weight.
+		`ALTER TABLE events DROP COLUMN IF EXISTS first_seen_block`,
 		// Index for fast lookups
 		`CREATE INDEX IF NOT EXISTS idx_events_hash ON events(event_hash)`,
 		`CREATE INDEX IF NOT EXISTS idx_events_task_id ON events(task_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_events_type ON events(event_type)`,
+		`CREATE INDEX IF NOT EXISTS idx_events_status ON events(status)`,
+		// Tracks how far each event source (webhook, rpc, ...) has read so
+		// it can resume after a restart instead of replaying from genesis.
+		`CREATE TABLE IF NOT EXISTS indexer_cursor (
+			source VARCHAR(50) PRIMARY KEY,
+			last_block BIGINT NOT NULL,
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+		)`,
+		// One row per processed event, appended to in event order. batch_id
+		// and leaf_index are NULL until the Anchorer includes the leaf in a
+		// batch.
+		`CREATE TABLE IF NOT EXISTS merkle_leaves (
+			id BIGSERIAL PRIMARY KEY,
+			event_hash VARCHAR(66) UNIQUE NOT NULL REFERENCES events(event_hash),
+			leaf_hash VARCHAR(66) NOT NULL,
+			batch_id BIGINT,
+			leaf_index BIGINT,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_merkle_leaves_batch ON merkle_leaves(batch_id)`,
+		// Every node of each anchored batch's tree, leaves included, keyed by
+		// level (0 = leaves) and index within that level, so a proof can be assembled
+		// by looking up siblings directly rather than rebuilding the tree.
+		`CREATE TABLE IF NOT EXISTS merkle_nodes (
+			batch_id BIGINT NOT NULL,
+			level INT NOT NULL,
+			index BIGINT NOT NULL,
+			hash VARCHAR(66) NOT NULL,
+			PRIMARY KEY (batch_id, level, index)
+		)`,
+		// One row per anchored batch. tx_hash is populated only when a
+		// signer is configured to submit the root on-chain.
+		`CREATE TABLE IF NOT EXISTS merkle_roots (
+			batch_id BIGSERIAL PRIMARY KEY,
+			root VARCHAR(66) NOT NULL,
+			leaf_count INT NOT NULL,
+			anchored_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			tx_hash VARCHAR(66)
+		)`,
 	}
 
 	for _, migration := range migrations {
@@ -0,0 +1,80 @@
+// Package httpserver wires the HTTP router and server into the fx
+// application graph.
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/contractsafe/api/internal/audit"
+	"github.com/contractsafe/api/internal/indexer"
+	"github.com/contractsafe/api/internal/webhook"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/fx"
+)
+
+// Module provides the router and HTTP server to the fx application graph,
+// starting the server OnStart and shutting it down gracefully OnStop.
+var Module = fx.Module("httpserver",
+	fx.Provide(NewRouter, NewServer),
+	fx.Invoke(registerHooks),
+)
+
+// NewRouter builds the application router. The Goldsky webhook route is
+// only registered when EVENT_SOURCES enables "webhook".
+func NewRouter(webhookHandler *webhook.Handler, auditHandler *audit.Handler) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/health", healthCheckHandler).Methods("GET")
+	router.HandleFunc("/audit/proof/{event_hash}", auditHandler.HandleProof).Methods("GET")
+	router.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	if indexer.EnabledSources()["webhook"] {
+		router.HandleFunc("/webhooks/goldsky", webhookHandler.HandleWebhook).Methods("POST")
+	}
+
+	return router
+}
+
+func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
+
+// NewServer builds the HTTP server using the PORT environment variable,
+// defaulting to 8080.
+func NewServer(router *mux.Router) *http.Server {
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+
+	return &http.Server{
+		Addr:         fmt.Sprintf(":%s", port),
+		Handler:      router,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+}
+
+func registerHooks(lc fx.Lifecycle, server *http.Server) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				log.Printf("Starting server on %s", server.Addr)
+				if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					log.Fatalf("Server failed: %v", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return server.Shutdown(ctx)
+		},
+	})
+}
@@ -0,0 +1,26 @@
+package indexer
+
+import (
+	"os"
+	"strings"
+)
+
+// EnabledSources parses the comma-separated EVENT_SOURCES env var (e.g.
+// "webhook,rpc") into a set. Defaults to webhook only, preserving behavior
+// from before the RPC source existed.
+func EnabledSources() map[string]bool {
+	raw := os.Getenv("EVENT_SOURCES")
+	if raw == "" {
+		raw = "webhook"
+	}
+
+	enabled := make(map[string]bool)
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" {
+			enabled[name] = true
+		}
+	}
+
+	return enabled
+}
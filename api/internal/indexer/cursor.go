@@ -0,0 +1,39 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// loadCursor returns the last block processed by source, or fromBlock if
+// the source has never checkpointed before.
+func loadCursor(ctx context.Context, db *sql.DB, source string, fromBlock uint64) (uint64, error) {
+	var lastBlock int64
+	query := `SELECT last_block FROM indexer_cursor WHERE source = $1`
+
+	err := db.QueryRowContext(ctx, query, source).Scan(&lastBlock)
+	if err == sql.ErrNoRows {
+		return fromBlock, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load cursor for %s: %w", source, err)
+	}
+
+	return uint64(lastBlock), nil
+}
+
+// saveCursor checkpoints the last block source has fully processed.
+func saveCursor(ctx context.Context, db *sql.DB, source string, block uint64) error {
+	query := `
+		INSERT INTO indexer_cursor (source, last_block, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (source) DO UPDATE SET last_block = EXCLUDED.last_block, updated_at = NOW()
+	`
+
+	if _, err := db.ExecContext(ctx, query, source, int64(block)); err != nil {
+		return fmt.Errorf("failed to save cursor for %s: %w", source, err)
+	}
+
+	return nil
+}
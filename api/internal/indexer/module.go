@@ -0,0 +1,52 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/contractsafe/api/internal/blockchain"
+	"github.com/contractsafe/api/internal/orchestrator"
+	"go.uber.org/fx"
+)
+
+// Module starts the RPC log-subscription source as an fx.Invoke
+// participant when EVENT_SOURCES enables "rpc". It is a no-op otherwise,
+// so it can always be included in the fx graph without touching main.
+var Module = fx.Module("indexer",
+	fx.Invoke(runRPCSource),
+)
+
+func runRPCSource(lc fx.Lifecycle, blockchainClient *blockchain.Client, db *sql.DB, orch *orchestrator.Orchestrator) error {
+	if !EnabledSources()["rpc"] {
+		return nil
+	}
+
+	fromBlock, _ := strconv.ParseUint(os.Getenv("INDEXER_FROM_BLOCK"), 10, 64)
+
+	source, err := NewRPCSource(blockchainClient.EthClient(), blockchainClient.ContractAddress(), db, orch, fromBlock)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				if err := source.Run(ctx); err != nil {
+					log.Printf("indexer(%s): stopped: %v", source.Name(), err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+
+	return nil
+}
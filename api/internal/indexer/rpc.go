@@ -0,0 +1,286 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/contractsafe/api/internal/blockchain/escrow"
+	"github.com/contractsafe/api/internal/orchestrator"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// sourceName is the value stored in indexer_cursor.source for the RPC
+// source, and the event source tag used in logs.
+const sourceName = "rpc"
+
+// reconcileInterval is how often the reconciliation FilterLogs sweep runs.
+// It is the only way forward progress happens against an HTTP-only RPC
+// endpoint, and a safety net against missed logs on a WSS endpoint whose
+// subscription dropped without the reconnect loop noticing yet.
+const reconcileInterval = 15 * time.Second
+
+// subscribeBackoffInitial / subscribeBackoffMax bound the reconnect delay
+// for the WSS log subscription.
+const (
+	subscribeBackoffInitial = 1 * time.Second
+	subscribeBackoffMax     = 30 * time.Second
+)
+
+// RPCSource reads Escrow contract logs directly from the chain via the RPC
+// node, decodes them into orchestrator.Event values, and forwards them
+// through orchestrator.ProcessEvent so dedup and routing are shared with
+// the webhook path.
+type RPCSource struct {
+	client          *ethclient.Client
+	filterer        *escrow.EscrowFilterer
+	contractAddress common.Address
+	db              *sql.DB
+	orch            *orchestrator.Orchestrator
+
+	// FromBlock seeds the cursor the first time this source runs; it has
+	// no effect once indexer_cursor has a row for "rpc".
+	FromBlock uint64
+}
+
+// NewRPCSource builds an RPCSource bound to contractAddress on client.
+func NewRPCSource(client *ethclient.Client, contractAddress common.Address, db *sql.DB, orch *orchestrator.Orchestrator, fromBlock uint64) (*RPCSource, error) {
+	filterer, err := escrow.NewEscrowFilterer(contractAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind escrow filterer: %w", err)
+	}
+
+	return &RPCSource{
+		client:          client,
+		filterer:        filterer,
+		contractAddress: contractAddress,
+		db:              db,
+		orch:            orch,
+		FromBlock:       fromBlock,
+	}, nil
+}
+
+// Name identifies this source as "rpc".
+func (s *RPCSource) Name() string {
+	return sourceName
+}
+
+// Run starts the reconciliation sweep and, where supported, a live log
+// subscription, and blocks until ctx is cancelled.
+func (s *RPCSource) Run(ctx context.Context) error {
+	errCh := make(chan error, 2)
+
+	go func() {
+		errCh <- s.runReconciliation(ctx)
+	}()
+	go func() {
+		errCh <- s.runSubscription(ctx)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			// A loop that exits cleanly (the subscription on an HTTP-only
+			// endpoint) leaves the other one running.
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// runReconciliation periodically replays FilterLogs from the last
+// checkpointed block to the current head. It works against both HTTP and
+// WSS endpoints and is the sole driver of progress on HTTP.
+func (s *RPCSource) runReconciliation(ctx context.Context) error {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+
+	if err := s.reconcileOnce(ctx); err != nil {
+		log.Printf("indexer(rpc): reconciliation error: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.reconcileOnce(ctx); err != nil {
+				log.Printf("indexer(rpc): reconciliation error: %v", err)
+			}
+		}
+	}
+}
+
+// reconcileOnce fetches logs from the checkpointed block through the
+// current head and forwards them to the orchestrator, advancing the cursor
+// only after every log in the range has been processed successfully.
+func (s *RPCSource) reconcileOnce(ctx context.Context) error {
+	from, err := loadCursor(ctx, s.db, sourceName, s.FromBlock)
+	if err != nil {
+		return err
+	}
+
+	head, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head block: %w", err)
+	}
+
+	if head <= from {
+		return nil
+	}
+
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from + 1),
+		ToBlock:   new(big.Int).SetUint64(head),
+		Addresses: []common.Address{s.contractAddress},
+	}
+
+	logs, err := s.client.FilterLogs(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to filter logs: %w", err)
+	}
+
+	for _, vLog := range logs {
+		if err := s.processLog(ctx, vLog); err != nil {
+			return fmt.Errorf("failed to process log in tx %s: %w", vLog.TxHash.Hex(), err)
+		}
+	}
+
+	return saveCursor(ctx, s.db, sourceName, head)
+}
+
+// runSubscription maintains a live eth_subscribe("logs") stream, reconnecting
+// with exponential backoff on drop. On HTTP-only RPC endpoints the first
+// SubscribeFilterLogs fails immediately, in which case the reconciliation
+// sweep above is the only event path and this loop exits quietly; once a
+// subscription has been established, failed resubscribes are retried.
+func (s *RPCSource) runSubscription(ctx context.Context) error {
+	backoff := subscribeBackoffInitial
+	subscribed := false
+
+	for {
+		logsCh := make(chan types.Log, 256)
+		sub, err := s.client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{s.contractAddress},
+		}, logsCh)
+		if err != nil {
+			if !subscribed {
+				log.Printf("indexer(rpc): log subscription unavailable (%v), relying on reconciliation sweep", err)
+				return nil
+			}
+			log.Printf("indexer(rpc): resubscribe failed: %v, retrying in %s", err, backoff)
+		} else {
+			subscribed = true
+			backoff = subscribeBackoffInitial
+			if err := s.drainSubscription(ctx, sub, logsCh); err != nil {
+				log.Printf("indexer(rpc): subscription dropped: %v, reconnecting in %s", err, backoff)
+			} else {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > subscribeBackoffMax {
+			backoff = subscribeBackoffMax
+		}
+	}
+}
+
+// drainSubscription consumes logs until ctx is cancelled or the
+// subscription errors out.
+//
+// The cursor must never advance past a log that failed to process, or the
+// reconciliation sweep's FilterLogs range (which starts at cursor+1) would
+// skip it forever. failedBlock tracks the lowest block number with a
+// processing failure still outstanding on this subscription; once set, the
+// cursor stops advancing until the reconciliation sweep has had a chance to
+// retry that block and move the checkpoint past it itself.
+func (s *RPCSource) drainSubscription(ctx context.Context, sub ethereum.Subscription, logsCh <-chan types.Log) error {
+	defer sub.Unsubscribe()
+
+	var failedBlock *uint64
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return err
+		case vLog := <-logsCh:
+			if err := s.processLog(ctx, vLog); err != nil {
+				log.Printf("indexer(rpc): failed to process live log in tx %s: %v", vLog.TxHash.Hex(), err)
+				if failedBlock == nil || vLog.BlockNumber < *failedBlock {
+					block := vLog.BlockNumber
+					failedBlock = &block
+				}
+				continue
+			}
+
+			if failedBlock != nil && vLog.BlockNumber >= *failedBlock {
+				continue
+			}
+
+			if err := saveCursor(ctx, s.db, sourceName, vLog.BlockNumber); err != nil {
+				log.Printf("indexer(rpc): failed to checkpoint cursor: %v", err)
+			}
+		}
+	}
+}
+
+// processLog decodes a single Escrow log and forwards it through
+// orchestrator.ProcessEvent, producing the same Event shape the webhook
+// path emits.
+func (s *RPCSource) processLog(ctx context.Context, vLog types.Log) error {
+	event, err := s.decodeEvent(vLog)
+	if err != nil {
+		return fmt.Errorf("failed to decode log: %w", err)
+	}
+	if event == nil {
+		// Not an event type we care about (e.g. ABI evolved ahead of us).
+		return nil
+	}
+
+	return s.orch.ProcessEvent(ctx, event)
+}
+
+// decodeEvent maps a raw Escrow log into an orchestrator.Event, returning
+// nil for log types we don't recognize.
+func (s *RPCSource) decodeEvent(vLog types.Log) (*orchestrator.Event, error) {
+	changed, err := s.filterer.ParseTaskStateChanged(vLog)
+	if err != nil {
+		return nil, nil
+	}
+
+	taskID := changed.TaskId.Int64()
+	eventType := "TaskStateChanged"
+	txHash := vLog.TxHash.Hex()
+	blockNumber := int64(vLog.BlockNumber)
+
+	return &orchestrator.Event{
+		Hash:            orchestrator.ComputeEventHash(eventType, taskID, blockNumber, txHash),
+		Type:            eventType,
+		TaskID:          taskID,
+		BlockNumber:     blockNumber,
+		BlockHash:       vLog.BlockHash.Hex(),
+		TransactionHash: txHash,
+		Payload: map[string]interface{}{
+			"previousState": changed.PreviousState,
+			"newState":      changed.NewState,
+		},
+	}, nil
+}
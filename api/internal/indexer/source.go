@@ -0,0 +1,19 @@
+// Package indexer contains event sources that feed on-chain events into the
+// orchestrator as an alternative (or complement) to the Goldsky webhook.
+package indexer
+
+import "context"
+
+// EventSource is a pluggable origin of on-chain events. Operators can run
+// any combination of sources concurrently; the orchestrator's event_hash
+// dedup guarantees that delivering the same event from more than one source
+// is harmless.
+type EventSource interface {
+	// Name identifies the source in logs, metrics, and the indexer_cursor
+	// table.
+	Name() string
+
+	// Run starts the source and blocks until ctx is cancelled or a
+	// non-recoverable error occurs.
+	Run(ctx context.Context) error
+}
@@ -0,0 +1,36 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/contractsafe/api/internal/audit"
+)
+
+// appendMerkleLeaf records the leaf hash for event in merkle_leaves. The
+// leaf is picked up, batched, and anchored by the Anchorer; until then it
+// sits with a NULL batch_id.
+//
+// It runs against tx, the same transaction storeEvent uses for the events
+// insert, so the two rows are committed (or rolled back) together.
+func (o *Orchestrator) appendMerkleLeaf(ctx context.Context, tx *sql.Tx, event *Event, payloadJSON []byte) error {
+	payloadHash := sha256.Sum256(payloadJSON)
+	data := fmt.Sprintf("%s:%s:%d:%d:%s:%x", event.Hash, event.Type, event.TaskID, event.BlockNumber, event.TransactionHash, payloadHash)
+	leaf := audit.LeafHash([]byte(data))
+
+	query := `
+		INSERT INTO merkle_leaves (event_hash, leaf_hash)
+		VALUES ($1, $2)
+		ON CONFLICT (event_hash) DO NOTHING
+	`
+
+	_, err := tx.ExecContext(ctx, query, event.Hash, "0x"+hex.EncodeToString(leaf[:]))
+	if err != nil {
+		return fmt.Errorf("failed to append merkle leaf: %w", err)
+	}
+
+	return nil
+}
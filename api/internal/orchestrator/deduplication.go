@@ -8,12 +8,23 @@ import (
 	"fmt"
 )
 
+
+// eventStatus tracks an event's progress through finality gating.
+type eventStatus string
+
+const (
+	eventStatusPending   eventStatus = "pending"
+	eventStatusFinalized eventStatus = "finalized"
+	eventStatusOrphaned  eventStatus = "orphaned"
+)
+
 // Event represents a blockchain event from Goldsky
 type Event struct {
 	Hash            string                 `json:"hash"`
 	Type            string                 `json:"type"`
 	TaskID          int64                  `json:"taskId"`
 	BlockNumber     int64                  `json:"blockNumber"`
+	BlockHash       string                 `json:"blockHash"`
 	TransactionHash string                 `json:"transactionHash"`
 	Payload         map[string]interface{} `json:"payload"`
 }
@@ -38,27 +49,42 @@ func (o *Orchestrator) isDuplicateEvent(ctx context.Context, eventHash string) (
 	return exists, nil
 }
 
-// storeEvent stores an event in the database
+// storeEvent stores an event in the database as pending and appends its
+// Merkle leaf in the same transaction; it is not routed until the
+// FinalityWorker promotes it to finalized.
+//
+// The two inserts must commit together: isDuplicateEvent only checks the
+// events table, so if the merkle_leaves insert failed after a standalone
+// events insert committed, a retried delivery would short-circuit on the
+// duplicate check and the event would never get an audit-log leaf.
 func (o *Orchestrator) storeEvent(ctx context.Context, event *Event) error {
 	payloadJSON, err := json.Marshal(event.Payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
+	tx, err := o.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO events (event_hash, event_type, task_id, block_number, transaction_hash, payload)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO events (event_hash, event_type, task_id, block_number, block_hash, transaction_hash, status, payload)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		ON CONFLICT (event_hash) DO NOTHING
 	`
 
-	_, err = o.db.ExecContext(
+	_, err = tx.ExecContext(
 		ctx,
 		query,
 		event.Hash,
 		event.Type,
 		event.TaskID,
 		event.BlockNumber,
+		event.BlockHash,
 		event.TransactionHash,
+		eventStatusPending,
 		payloadJSON,
 	)
 
@@ -66,19 +92,27 @@ func (o *Orchestrator) storeEvent(ctx context.Context, event *Event) error {
 		return fmt.Errorf("failed to store event: %w", err)
 	}
 
+	if err := o.appendMerkleLeaf(ctx, tx, event, payloadJSON); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit event: %w", err)
+	}
+
 	return nil
 }
 
-// GetProcessedEvents retrieves processed events for a task
+// GetProcessedEvents retrieves finalized events for a task
 func (o *Orchestrator) GetProcessedEvents(ctx context.Context, taskID int64) ([]Event, error) {
 	query := `
-		SELECT event_hash, event_type, task_id, block_number, transaction_hash, payload
+		SELECT event_hash, event_type, task_id, block_number, block_hash, transaction_hash, payload
 		FROM events
-		WHERE task_id = $1
+		WHERE task_id = $1 AND status = $2
 		ORDER BY block_number ASC, id ASC
 	`
 
-	rows, err := o.db.QueryContext(ctx, query, taskID)
+	rows, err := o.db.QueryContext(ctx, query, taskID, eventStatusFinalized)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query events: %w", err)
 	}
@@ -94,6 +128,7 @@ func (o *Orchestrator) GetProcessedEvents(ctx context.Context, taskID int64) ([]
 			&event.Type,
 			&event.TaskID,
 			&event.BlockNumber,
+			&event.BlockHash,
 			&event.TransactionHash,
 			&payloadJSON,
 		)
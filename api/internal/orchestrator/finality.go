@@ -0,0 +1,187 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// finalityPollInterval is how often the FinalityWorker checks for pending
+// events that have cleared ConfirmationDepth.
+const finalityPollInterval = 15 * time.Second
+
+// orphanedEventsTotal counts pending events orphaned by a reorg, broken
+// down by event type, so a spike in reorg activity shows up on dashboards
+// rather than only in logs.
+var orphanedEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "contractsafe_orchestrator_orphaned_events_total",
+	Help: "Total number of pending events marked orphaned due to a detected reorg.",
+}, []string{"event_type"})
+
+// pendingEventRow mirrors the columns needed to evaluate and, if eligible,
+// route a pending event.
+type pendingEventRow struct {
+	id    int64
+	event Event
+}
+
+// FinalityWorker promotes pending events to finalized once they sit behind
+// the chain head by at least the orchestrator's ConfirmationDepth, and
+// marks them orphaned if the block they were seen in was reorged out.
+type FinalityWorker struct {
+	orch *Orchestrator
+}
+
+// NewFinalityWorker creates a FinalityWorker for orch.
+func NewFinalityWorker(orch *Orchestrator) *FinalityWorker {
+	return &FinalityWorker{orch: orch}
+}
+
+// Run polls for eligible pending events until ctx is cancelled.
+func (w *FinalityWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(finalityPollInterval)
+	defer ticker.Stop()
+
+	if err := w.promotePending(ctx); err != nil {
+		log.Printf("finality worker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.promotePending(ctx); err != nil {
+				log.Printf("finality worker: %v", err)
+			}
+		}
+	}
+}
+
+// promotePending loads pending events old enough to evaluate, checks each
+// against the current chain state, and promotes or orphans it.
+func (w *FinalityWorker) promotePending(ctx context.Context) error {
+	head, err := w.orch.blockchainClient.HeadBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head block: %w", err)
+	}
+
+	rows, err := w.loadEligiblePending(ctx, int64(head))
+	if err != nil {
+		return fmt.Errorf("failed to load pending events: %w", err)
+	}
+
+	for _, row := range rows {
+		if err := w.evaluate(ctx, row); err != nil {
+			log.Printf("finality worker: failed to evaluate event %s: %v", row.event.Hash, err)
+		}
+	}
+
+	return nil
+}
+
+// loadEligiblePending returns pending events whose block_number + depth has
+// cleared head.
+func (w *FinalityWorker) loadEligiblePending(ctx context.Context, head int64) ([]pendingEventRow, error) {
+	query := `
+		SELECT id, event_hash, event_type, task_id, block_number, block_hash, transaction_hash, payload
+		FROM events
+		WHERE status = $1 AND block_number + $2 <= $3
+		ORDER BY block_number ASC, id ASC
+	`
+
+	rows, err := w.orch.db.QueryContext(ctx, query, eventStatusPending, w.orch.confirmationDepth, head)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []pendingEventRow
+	for rows.Next() {
+		var row pendingEventRow
+		var payloadJSON []byte
+
+		if err := rows.Scan(
+			&row.id,
+			&row.event.Hash,
+			&row.event.Type,
+			&row.event.TaskID,
+			&row.event.BlockNumber,
+			&row.event.BlockHash,
+			&row.event.TransactionHash,
+			&payloadJSON,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(payloadJSON, &row.event.Payload); err != nil {
+			return nil, err
+		}
+
+		out = append(out, row)
+	}
+
+	return out, nil
+}
+
+// evaluate checks a single pending event against the chain and promotes it
+// to finalized (routing it) or marks it orphaned if the original block was
+// reorged away.
+func (w *FinalityWorker) evaluate(ctx context.Context, row pendingEventRow) error {
+	canonicalHash, err := w.orch.blockchainClient.BlockHashAt(ctx, row.event.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch canonical block hash: %w", err)
+	}
+
+	if row.event.BlockHash != "" && canonicalHash.Hex() != row.event.BlockHash {
+		return w.orphan(ctx, row)
+	}
+
+	txHash := common.HexToHash(row.event.TransactionHash)
+	receipt, err := w.orch.blockchainClient.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch transaction receipt: %w", err)
+	}
+	if receipt == nil {
+		return w.orphan(ctx, row)
+	}
+
+	// Route before marking finalized: routeEvent can fail transiently (e.g.
+	// a Redis/queue blip), and loadEligiblePending only ever selects
+	// status = pending, so a row already marked finalized would never be
+	// retried. Routing first means a failure here just leaves the row
+	// pending for the next poll to retry.
+	if err := w.orch.routeEvent(ctx, &row.event); err != nil {
+		return fmt.Errorf("failed to route finalized event: %w", err)
+	}
+
+	if err := w.markFinalized(ctx, row.id); err != nil {
+		return fmt.Errorf("failed to mark event finalized: %w", err)
+	}
+
+	return nil
+}
+
+// orphan marks a pending event as orphaned. Orphaned events are never
+// routed.
+func (w *FinalityWorker) orphan(ctx context.Context, row pendingEventRow) error {
+	log.Printf("finality worker: orphaning event %s (task %d, block %d): reorg detected", row.event.Hash, row.event.TaskID, row.event.BlockNumber)
+	orphanedEventsTotal.WithLabelValues(row.event.Type).Inc()
+
+	query := `UPDATE events SET status = $1 WHERE id = $2`
+	_, err := w.orch.db.ExecContext(ctx, query, eventStatusOrphaned, row.id)
+	return err
+}
+
+// markFinalized flips a pending event's status to finalized.
+func (w *FinalityWorker) markFinalized(ctx context.Context, id int64) error {
+	query := `UPDATE events SET status = $1, finalized_at = NOW() WHERE id = $2`
+	_, err := w.orch.db.ExecContext(ctx, query, eventStatusFinalized, id)
+	return err
+}
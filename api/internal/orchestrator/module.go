@@ -0,0 +1,51 @@
+package orchestrator
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/contractsafe/api/internal/blockchain"
+	"github.com/contractsafe/api/internal/queue"
+	"go.uber.org/fx"
+)
+
+// Module provides the Orchestrator to the fx application graph and runs
+// the FinalityWorker for its lifetime.
+var Module = fx.Module("orchestrator",
+	fx.Provide(newFromEnv),
+	fx.Invoke(runFinalityWorker),
+)
+
+// newFromEnv builds an Orchestrator using CONFIRMATION_DEPTH, falling back
+// to defaultConfirmationDepth when unset.
+func newFromEnv(db *sql.DB, jobQueue *queue.JobQueue, blockchainClient *blockchain.Client) *Orchestrator {
+	var confirmationDepth int64
+	if v := os.Getenv("CONFIRMATION_DEPTH"); v != "" {
+		confirmationDepth, _ = strconv.ParseInt(v, 10, 64)
+	}
+
+	return NewOrchestrator(db, jobQueue, blockchainClient, confirmationDepth)
+}
+
+func runFinalityWorker(lc fx.Lifecycle, orch *Orchestrator) {
+	worker := NewFinalityWorker(orch)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				if err := worker.Run(ctx); err != nil {
+					log.Printf("finality worker: stopped: %v", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
@@ -7,25 +7,42 @@ import (
 
 	"github.com/contractsafe/api/internal/blockchain"
 	"github.com/contractsafe/api/internal/queue"
+	"github.com/hibiken/asynq"
 )
 
+// defaultConfirmationDepth is how many blocks must pass before a pending
+// event is considered final on Polygon, chosen to clear typical
+// sub-finality reorgs between heimdall checkpoints.
+const defaultConfirmationDepth = 128
+
 // Orchestrator coordinates event processing
 type Orchestrator struct {
-	db               *sql.DB
-	queue            *queue.JobQueue
-	blockchainClient *blockchain.Client
+	db                *sql.DB
+	queue             *queue.JobQueue
+	blockchainClient  *blockchain.Client
+	confirmationDepth int64
 }
 
-// NewOrchestrator creates a new orchestrator
-func NewOrchestrator(db *sql.DB, jobQueue *queue.JobQueue, blockchainClient *blockchain.Client) *Orchestrator {
+// NewOrchestrator creates a new orchestrator. confirmationDepth is the
+// number of blocks a pending event must sit behind the chain head before
+// the FinalityWorker promotes it; pass 0 to use defaultConfirmationDepth.
+func NewOrchestrator(db *sql.DB, jobQueue *queue.JobQueue, blockchainClient *blockchain.Client, confirmationDepth int64) *Orchestrator {
+	if confirmationDepth <= 0 {
+		confirmationDepth = defaultConfirmationDepth
+	}
+
 	return &Orchestrator{
-		db:               db,
-		queue:            jobQueue,
-		blockchainClient: blockchainClient,
+		db:                db,
+		queue:             jobQueue,
+		blockchainClient:  blockchainClient,
+		confirmationDepth: confirmationDepth,
 	}
 }
 
-// ProcessEvent processes an incoming event from Goldsky
+// ProcessEvent processes an incoming event from Goldsky or the RPC indexer.
+// Events are stored as pending and are not routed until the FinalityWorker
+// promotes them to finalized, since a raw on-chain log can still be
+// reverted by a reorg.
 func (o *Orchestrator) ProcessEvent(ctx context.Context, event *Event) error {
 	// Check for duplicate
 	isDuplicate, err := o.isDuplicateEvent(ctx, event.Hash)
@@ -38,20 +55,19 @@ func (o *Orchestrator) ProcessEvent(ctx context.Context, event *Event) error {
 		return nil
 	}
 
-	// Store event
+	// Store event as pending; FinalityWorker routes it once finalized.
 	if err := o.storeEvent(ctx, event); err != nil {
 		return fmt.Errorf("failed to store event: %w", err)
 	}
 
-	// Route event
-	if err := o.routeEvent(ctx, event); err != nil {
-		return fmt.Errorf("failed to route event: %w", err)
-	}
-
 	return nil
 }
 
-// routeEvent routes an event to the appropriate handler
+// routeEvent routes an event to the appropriate handler. It may be called
+// more than once for the same event (the FinalityWorker retries routing if
+// marking the event finalized afterwards fails), so every enqueue below is
+// keyed on event.Hash via asynq.TaskID: a repeat route is a no-op rather
+// than a second refund/validation job.
 func (o *Orchestrator) routeEvent(ctx context.Context, event *Event) error {
 	switch event.Type {
 	case "ApprovalRequested":
@@ -85,7 +101,7 @@ func (o *Orchestrator) handleApprovalRequested(ctx context.Context, event *Event
 			Data:            event.Payload,
 		}
 
-		return o.queue.EnqueueWithRetry(ctx, queue.JobTypeRunAICheck, payload, 3)
+		return o.queue.EnqueueWithRetry(ctx, queue.JobTypeRunAICheck, payload, 3, asynq.TaskID(event.Hash))
 	}
 
 	// For human validators, just enqueue a validation request
@@ -97,7 +113,7 @@ func (o *Orchestrator) handleApprovalRequested(ctx context.Context, event *Event
 		Data:            event.Payload,
 	}
 
-	return o.queue.EnqueueWithRetry(ctx, queue.JobTypeRequestValidation, payload, 3)
+	return o.queue.EnqueueWithRetry(ctx, queue.JobTypeRequestValidation, payload, 3, asynq.TaskID(event.Hash))
 }
 
 // handleTaskRejected handles TaskRejected events
@@ -113,5 +129,5 @@ func (o *Orchestrator) handleTaskRejected(ctx context.Context, event *Event) err
 		Data:            event.Payload,
 	}
 
-	return o.queue.EnqueueWithRetry(ctx, queue.JobTypeProcessRefund, payload, 3)
+	return o.queue.EnqueueWithRetry(ctx, queue.JobTypeProcessRefund, payload, 3, asynq.TaskID(event.Hash))
 }
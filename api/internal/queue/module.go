@@ -0,0 +1,36 @@
+package queue
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/fx"
+)
+
+// Module provides the Redis client and JobQueue to the fx application
+// graph, and closes both (the queue's client before the Redis client) on
+// shutdown.
+var Module = fx.Module("queue",
+	fx.Provide(
+		newRedisClientFromEnv,
+		NewJobQueue,
+	),
+	fx.Invoke(registerHooks),
+)
+
+// newRedisClientFromEnv connects to Redis using REDIS_URL.
+func newRedisClientFromEnv() (*redis.Client, error) {
+	return NewRedisClient(os.Getenv("REDIS_URL"))
+}
+
+func registerHooks(lc fx.Lifecycle, redisClient *redis.Client, jobQueue *JobQueue) {
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			if err := jobQueue.Close(); err != nil {
+				return err
+			}
+			return redisClient.Close()
+		},
+	})
+}
@@ -3,6 +3,7 @@ package queue
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -47,7 +48,11 @@ func NewJobQueue(redisClient *redis.Client) *JobQueue {
 	}
 }
 
-// EnqueueJob adds a job to the queue
+// EnqueueJob adds a job to the queue. If opts includes asynq.TaskID and a
+// task with that ID is already queued or in flight, the enqueue is treated
+// as a no-op rather than an error, so callers re-routing an event they've
+// already enqueued (e.g. after a transient failure marking it finalized)
+// don't double-process it.
 func (q *JobQueue) EnqueueJob(ctx context.Context, jobType JobType, payload JobPayload, opts ...asynq.Option) error {
 	data, err := json.Marshal(payload)
 	if err != nil {
@@ -55,9 +60,13 @@ func (q *JobQueue) EnqueueJob(ctx context.Context, jobType JobType, payload JobP
 	}
 
 	task := asynq.NewTask(string(jobType), data, opts...)
-	
+
 	info, err := q.client.Enqueue(task)
 	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) || errors.Is(err, asynq.ErrDuplicateTask) {
+			fmt.Printf("Skipping duplicate job: type=%s\n", jobType)
+			return nil
+		}
 		return fmt.Errorf("failed to enqueue task: %w", err)
 	}
 
@@ -65,13 +74,15 @@ func (q *JobQueue) EnqueueJob(ctx context.Context, jobType JobType, payload JobP
 	return nil
 }
 
-// EnqueueWithRetry adds a job with retry policy
-func (q *JobQueue) EnqueueWithRetry(ctx context.Context, jobType JobType, payload JobPayload, maxRetries int) error {
-	opts := []asynq.Option{
+// EnqueueWithRetry adds a job with retry policy. Extra opts (e.g.
+// asynq.TaskID to make the enqueue idempotent) are appended after the
+// default retry/timeout options.
+func (q *JobQueue) EnqueueWithRetry(ctx context.Context, jobType JobType, payload JobPayload, maxRetries int, opts ...asynq.Option) error {
+	allOpts := append([]asynq.Option{
 		asynq.MaxRetry(maxRetries),
 		asynq.Timeout(5 * time.Minute),
-	}
-	return q.EnqueueJob(ctx, jobType, payload, opts...)
+	}, opts...)
+	return q.EnqueueJob(ctx, jobType, payload, allOpts...)
 }
 
 // Close closes the queue client
@@ -2,12 +2,15 @@ package queue
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-redis/redis/v8"
 )
 
-// NewRedisClient creates a new Redis client
-func NewRedisClient(redisURL string) *redis.Client {
+// NewRedisClient creates a new Redis client. It returns an error rather
+// than panicking so callers (including fx's dependency graph) can surface
+// a connection failure as a normal startup error.
+func NewRedisClient(redisURL string) (*redis.Client, error) {
 	opt, err := redis.ParseURL(redisURL)
 	if err != nil {
 		// Fallback to default localhost
@@ -21,8 +24,8 @@ func NewRedisClient(redisURL string) *redis.Client {
 	// Test connection
 	ctx := context.Background()
 	if err := client.Ping(ctx).Err(); err != nil {
-		panic("Failed to connect to Redis: " + err.Error())
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
 	}
 
-	return client
+	return client, nil
 }
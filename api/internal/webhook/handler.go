@@ -58,6 +58,7 @@ func (h *Handler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		Type:            payload.EventType,
 		TaskID:          payload.TaskID,
 		BlockNumber:     payload.BlockNumber,
+		BlockHash:       payload.BlockHash,
 		TransactionHash: payload.TransactionHash,
 		Payload:         payload.Data,
 	}
@@ -87,6 +88,7 @@ type WebhookPayload struct {
 	EventType       string                 `json:"eventType"`
 	TaskID          int64                  `json:"taskId"`
 	BlockNumber     int64                  `json:"blockNumber"`
+	BlockHash       string                 `json:"blockHash"`
 	TransactionHash string                 `json:"transactionHash"`
 	Data            map[string]interface{} `json:"data"`
 }
@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	"os"
+
+	"github.com/contractsafe/api/internal/orchestrator"
+	"go.uber.org/fx"
+)
+
+// Module provides the webhook Handler to the fx application graph.
+var Module = fx.Module("webhook",
+	fx.Provide(newFromEnv),
+)
+
+// newFromEnv builds a Handler using GOLDSKY_WEBHOOK_SECRET.
+func newFromEnv(orch *orchestrator.Orchestrator) *Handler {
+	return NewHandler(orch, os.Getenv("GOLDSKY_WEBHOOK_SECRET"))
+}